@@ -1,16 +1,20 @@
 module github.com/crusttech/crust-server
 
-go 1.12
+go 1.15
 
 require (
 	github.com/cortezaproject/corteza-server v0.0.0-20200110160908-6f0a7efb96b4
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
+	github.com/go-ldap/ldap/v3 v3.1.10 // indirect
 	github.com/joho/godotenv v1.3.0
 	github.com/kr/pretty v0.1.0 // indirect
+	github.com/oklog/ulid v1.3.1 // indirect
 	github.com/prometheus/client_golang v0.9.3 // indirect
 	github.com/spf13/cobra v0.0.3
 	go.uber.org/zap v1.10.0
+	golang.org/x/time v0.0.0-20191024005414-555d28b269f0 // indirect
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
 )
 
 replace gopkg.in/Masterminds/squirrel.v1 => github.com/Masterminds/squirrel v1.1.0
+replace github.com/cortezaproject/corteza-server => ./vendor/github.com/cortezaproject/corteza-server